@@ -0,0 +1,538 @@
+/*
+Copyright 2018 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package meta contains functions for dealing with Kubernetes object
+// metadata, for example owner references, labels, and annotations.
+package meta
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// TypedObject is a Kubernetes object that knows its own Group, Version, and
+// Kind in addition to the usual object metadata.
+type TypedObject interface {
+	metav1.Object
+	runtime.Object
+}
+
+// AsOwner converts the supplied object reference to an owner reference.
+func AsOwner(r *corev1.ObjectReference) metav1.OwnerReference {
+	return metav1.OwnerReference{
+		APIVersion: r.APIVersion,
+		Kind:       r.Kind,
+		Name:       r.Name,
+		UID:        r.UID,
+	}
+}
+
+// AsController converts the supplied object reference to a controller
+// reference by setting its Controller field to true.
+func AsController(r *corev1.ObjectReference) metav1.OwnerReference {
+	c := true
+	ref := AsOwner(r)
+	ref.Controller = &c
+	return ref
+}
+
+// HaveSameController returns true if both supplied objects are controlled by
+// the same object.
+func HaveSameController(a, b metav1.Object) bool {
+	ac := metav1.GetControllerOf(a)
+	bc := metav1.GetControllerOf(b)
+
+	if ac == nil || bc == nil {
+		return false
+	}
+
+	return ac.UID == bc.UID
+}
+
+// HasOwner returns true if the supplied object has an owner reference
+// matching the supplied reference's APIVersion, Kind, Name, and UID.
+func HasOwner(o metav1.Object, r metav1.OwnerReference) bool {
+	for _, ref := range o.GetOwnerReferences() {
+		if ref.APIVersion == r.APIVersion && ref.Kind == r.Kind && ref.Name == r.Name && ref.UID == r.UID {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAnyOwner returns true if the supplied object has at least one owner
+// reference.
+func HasAnyOwner(o metav1.Object) bool {
+	return len(o.GetOwnerReferences()) > 0
+}
+
+// HasController returns true if the supplied object has an owner reference
+// whose Controller field is set to true.
+func HasController(o metav1.Object) bool {
+	return metav1.GetControllerOf(o) != nil
+}
+
+// WasCreatedByKind returns true if the supplied object has an owner
+// reference matching the supplied GroupVersionKind.
+func WasCreatedByKind(o metav1.Object, gvk schema.GroupVersionKind) bool {
+	v, k := gvk.ToAPIVersionAndKind()
+	for _, ref := range o.GetOwnerReferences() {
+		if ref.APIVersion == v && ref.Kind == k {
+			return true
+		}
+	}
+	return false
+}
+
+// ShouldAdopt returns true if the supplied object has no owner references,
+// indicating that a reconciler may safely attach one of its own.
+func ShouldAdopt(o metav1.Object) bool {
+	return len(o.GetOwnerReferences()) == 0
+}
+
+// NamespacedNameOf returns the referenced object's namespaced name.
+func NamespacedNameOf(r *corev1.ObjectReference) types.NamespacedName {
+	return types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+}
+
+// ReferenceTo returns an object reference to the supplied object, using its
+// GroupVersionKind if it is set.
+func ReferenceTo(o TypedObject) *corev1.ObjectReference {
+	v, k := o.GetObjectKind().GroupVersionKind().ToAPIVersionAndKind()
+	return &corev1.ObjectReference{
+		APIVersion: v,
+		Kind:       k,
+		Namespace:  o.GetNamespace(),
+		Name:       o.GetName(),
+		UID:        o.GetUID(),
+	}
+}
+
+// AddOwnerReference to the supplied object. Any existing owner reference with
+// the same UID as the supplied reference is replaced.
+func AddOwnerReference(o metav1.Object, r metav1.OwnerReference) {
+	refs := o.GetOwnerReferences()
+	for i, ref := range refs {
+		if ref.UID == r.UID {
+			refs[i] = r
+			o.SetOwnerReferences(refs)
+			return
+		}
+	}
+
+	o.SetOwnerReferences(append(refs, r))
+}
+
+// EnsureOwnerReference adds the supplied owner reference to the supplied
+// object, replacing any existing reference that has the same APIVersion,
+// Kind, and Name but a different UID - for example because the owner was
+// deleted and recreated. It returns true if the object's owner references
+// were mutated, so that callers can skip unnecessary updates.
+func EnsureOwnerReference(o metav1.Object, r metav1.OwnerReference) (mutated bool) {
+	refs := o.GetOwnerReferences()
+	for i, ref := range refs {
+		if ref.APIVersion == r.APIVersion && ref.Kind == r.Kind && ref.Name == r.Name {
+			if ref.UID == r.UID {
+				return false
+			}
+			refs[i] = r
+			o.SetOwnerReferences(refs)
+			return true
+		}
+	}
+
+	o.SetOwnerReferences(append(refs, r))
+	return true
+}
+
+// RemoveOwnerReference from the supplied object's metadata. The reference is
+// matched on APIVersion, Kind, Name, and UID.
+func RemoveOwnerReference(o metav1.Object, r metav1.OwnerReference) {
+	refs := o.GetOwnerReferences()
+	for i, ref := range refs {
+		if ref.APIVersion == r.APIVersion && ref.Kind == r.Kind && ref.Name == r.Name && ref.UID == r.UID {
+			refs = append(refs[:i], refs[i+1:]...)
+			break
+		}
+	}
+	o.SetOwnerReferences(refs)
+}
+
+// AddFinalizer to the supplied object's metadata.
+func AddFinalizer(o metav1.Object, finalizer string) {
+	f := o.GetFinalizers()
+	for _, e := range f {
+		if e == finalizer {
+			return
+		}
+	}
+	o.SetFinalizers(append(f, finalizer))
+}
+
+// RemoveFinalizer from the supplied object's metadata.
+func RemoveFinalizer(o metav1.Object, finalizer string) {
+	f := o.GetFinalizers()
+	for i, e := range f {
+		if e == finalizer {
+			f = append(f[:i], f[i+1:]...)
+		}
+	}
+	o.SetFinalizers(f)
+}
+
+// AddLabels to the supplied object's metadata, overwriting any existing
+// labels with the same key.
+func AddLabels(o metav1.Object, labels map[string]string) {
+	l := o.GetLabels()
+	if l == nil {
+		l = map[string]string{}
+	}
+	for k, v := range labels {
+		l[k] = v
+	}
+	o.SetLabels(l)
+}
+
+// RemoveLabels with the supplied keys from the supplied object's metadata.
+func RemoveLabels(o metav1.Object, labels ...string) {
+	l := o.GetLabels()
+	for _, k := range labels {
+		delete(l, k)
+	}
+	o.SetLabels(l)
+}
+
+// AddAnnotations to the supplied object's metadata, overwriting any existing
+// annotations with the same key.
+func AddAnnotations(o metav1.Object, annotations map[string]string) {
+	a := o.GetAnnotations()
+	if a == nil {
+		a = map[string]string{}
+	}
+	for k, v := range annotations {
+		a[k] = v
+	}
+	o.SetAnnotations(a)
+}
+
+// RemoveAnnotations with the supplied keys from the supplied object's
+// metadata.
+func RemoveAnnotations(o metav1.Object, annotations ...string) {
+	a := o.GetAnnotations()
+	for _, k := range annotations {
+		delete(a, k)
+	}
+	o.SetAnnotations(a)
+}
+
+// A PropagateOption configures the behavior of PropagateLabels or
+// PropagateAnnotations.
+type PropagateOption func(*propagateConfig)
+
+type propagateConfig struct {
+	prefix    string
+	allowList []string
+	denyList  []string
+	overwrite bool
+}
+
+// WithKeyPrefix propagates only keys with the supplied prefix.
+func WithKeyPrefix(prefix string) PropagateOption {
+	return func(c *propagateConfig) {
+		c.prefix = prefix
+	}
+}
+
+// WithKeyAllowList propagates only the supplied keys.
+func WithKeyAllowList(keys ...string) PropagateOption {
+	return func(c *propagateConfig) {
+		c.allowList = keys
+	}
+}
+
+// WithKeyDenyList never propagates the supplied keys, even if they match a
+// prefix or allow list.
+func WithKeyDenyList(keys ...string) PropagateOption {
+	return func(c *propagateConfig) {
+		c.denyList = keys
+	}
+}
+
+// WithOverwrite controls whether a propagated value overwrites a value the
+// child already has for the same key. It defaults to false, so that the
+// child's existing values win over the parent's.
+func WithOverwrite(overwrite bool) PropagateOption {
+	return func(c *propagateConfig) {
+		c.overwrite = overwrite
+	}
+}
+
+func (c *propagateConfig) allowed(key string) bool {
+	if c.prefix != "" && !strings.HasPrefix(key, c.prefix) {
+		return false
+	}
+
+	if len(c.allowList) > 0 {
+		found := false
+		for _, k := range c.allowList {
+			if k == key {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	for _, k := range c.denyList {
+		if k == key {
+			return false
+		}
+	}
+
+	return true
+}
+
+func propagate(from, to map[string]string, c *propagateConfig) map[string]string {
+	out := map[string]string{}
+	for k, v := range from {
+		if !c.allowed(k) {
+			continue
+		}
+		if !c.overwrite {
+			if _, ok := to[k]; ok {
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// PropagateLabels from parent to child, merging into any labels child
+// already has. By default an existing label on child is left untouched; use
+// WithOverwrite to let parent's value win instead.
+func PropagateLabels(parent, child metav1.Object, opts ...PropagateOption) {
+	c := &propagateConfig{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	add := propagate(parent.GetLabels(), child.GetLabels(), c)
+	if len(add) == 0 {
+		return
+	}
+	AddLabels(child, add)
+}
+
+// PropagateAnnotations from parent to child, merging into any annotations
+// child already has. By default an existing annotation on child is left
+// untouched; use WithOverwrite to let parent's value win instead.
+func PropagateAnnotations(parent, child metav1.Object, opts ...PropagateOption) {
+	c := &propagateConfig{}
+	for _, o := range opts {
+		o(c)
+	}
+
+	add := propagate(parent.GetAnnotations(), child.GetAnnotations(), c)
+	if len(add) == 0 {
+		return
+	}
+	AddAnnotations(child, add)
+}
+
+// AlreadyOwnedError is returned by SetControllerReference and
+// EnsureControllerReference when the controlled object is already
+// controlled by an owner other than the one supplied.
+type AlreadyOwnedError struct {
+	Object metav1.Object
+	Owner  metav1.OwnerReference
+}
+
+func (e *AlreadyOwnedError) Error() string {
+	return fmt.Sprintf("object %q is already owned by controller %s %q (UID %s)", e.Object.GetName(), e.Owner.Kind, e.Owner.Name, e.Owner.UID)
+}
+
+// gvkForObject derives the GroupVersionKind of the supplied object from the
+// supplied scheme, ignoring any TypeMeta the object may or may not have set.
+func gvkForObject(o runtime.Object, scheme *runtime.Scheme) (schema.GroupVersionKind, error) {
+	gvks, _, err := scheme.ObjectKinds(o)
+	if err != nil {
+		return schema.GroupVersionKind{}, errors.Wrap(err, "cannot determine GroupVersionKind")
+	}
+	if len(gvks) == 0 {
+		return schema.GroupVersionKind{}, errors.Errorf("scheme has no GroupVersionKind registered for %T", o)
+	}
+	return gvks[0], nil
+}
+
+// SetControllerReference sets owner as the controller owner reference of
+// controlled. The owner's GroupVersionKind is derived from the supplied
+// scheme rather than trusting a possibly-empty TypeMeta. SetControllerReference
+// returns an error if owner and controlled have different non-empty
+// namespaces, or if owner is namespaced and controlled is cluster scoped. It
+// returns an *AlreadyOwnedError if controlled is already controlled by an
+// owner other than the one supplied.
+func SetControllerReference(owner, controlled metav1.Object, scheme *runtime.Scheme) error {
+	ro, ok := owner.(runtime.Object)
+	if !ok {
+		return errors.Errorf("owner %T does not implement runtime.Object", owner)
+	}
+
+	gvk, err := gvkForObject(ro, scheme)
+	if err != nil {
+		return err
+	}
+
+	if owner.GetNamespace() != "" {
+		if controlled.GetNamespace() == "" {
+			return errors.Errorf("cannot set namespaced owner %q as controller of cluster-scoped %q", owner.GetName(), controlled.GetName())
+		}
+		if owner.GetNamespace() != controlled.GetNamespace() {
+			return errors.Errorf("cannot set owner %q in namespace %q as controller of %q in namespace %q", owner.GetName(), owner.GetNamespace(), controlled.GetName(), controlled.GetNamespace())
+		}
+	}
+
+	ref := AsController(&corev1.ObjectReference{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       owner.GetName(),
+		UID:        owner.GetUID(),
+	})
+	block := true
+	ref.BlockOwnerDeletion = &block
+
+	if existing := metav1.GetControllerOf(controlled); existing != nil && existing.UID != ref.UID {
+		return &AlreadyOwnedError{Object: controlled, Owner: *existing}
+	}
+
+	AddOwnerReference(controlled, ref)
+	return nil
+}
+
+// EnsureControllerReference behaves exactly like SetControllerReference,
+// except that it also reports whether controlled's owner references were
+// mutated so that callers can skip unnecessary updates.
+func EnsureControllerReference(owner, controlled metav1.Object, scheme *runtime.Scheme) (mutated bool, err error) {
+	before := append([]metav1.OwnerReference{}, controlled.GetOwnerReferences()...)
+
+	if err := SetControllerReference(owner, controlled, scheme); err != nil {
+		return false, err
+	}
+
+	return !reflect.DeepEqual(before, controlled.GetOwnerReferences()), nil
+}
+
+const (
+	// AnnotationKeyExternalName is the key in the annotations map of a
+	// resource that records its name as it exists outside of Kubernetes, for
+	// example inside a cloud provider.
+	AnnotationKeyExternalName = "crossplane.io/external-name"
+
+	// AnnotationKeyReconciliationPaused marks a resource as paused, telling
+	// its reconciler to stop processing it until the annotation is removed
+	// or set to any value other than "true".
+	AnnotationKeyReconciliationPaused = "crossplane.io/paused"
+
+	// AnnotationKeyExternalCreatePending marks the time at which a resource
+	// was requested to be created in the external system.
+	AnnotationKeyExternalCreatePending = "crossplane.io/external-create-pending"
+
+	// AnnotationKeyExternalCreateSucceeded marks the time at which a
+	// resource was confirmed to have been created in the external system.
+	AnnotationKeyExternalCreateSucceeded = "crossplane.io/external-create-succeeded"
+
+	// AnnotationKeyExternalCreateFailed marks the time at which a resource
+	// failed to be created in the external system.
+	AnnotationKeyExternalCreateFailed = "crossplane.io/external-create-failed"
+)
+
+// GetExternalName returns the external name annotation value on the supplied
+// object, if any.
+func GetExternalName(o metav1.Object) string {
+	return o.GetAnnotations()[AnnotationKeyExternalName]
+}
+
+// SetExternalName sets the external name annotation on the supplied object.
+func SetExternalName(o metav1.Object, name string) {
+	AddAnnotations(o, map[string]string{AnnotationKeyExternalName: name})
+}
+
+// IsPaused returns true if the supplied object has the paused annotation set
+// to "true".
+func IsPaused(o metav1.Object) bool {
+	return o.GetAnnotations()[AnnotationKeyReconciliationPaused] == "true"
+}
+
+// SetPaused sets or clears the paused annotation on the supplied object.
+func SetPaused(o metav1.Object, paused bool) {
+	if !paused {
+		RemoveAnnotations(o, AnnotationKeyReconciliationPaused)
+		return
+	}
+	AddAnnotations(o, map[string]string{AnnotationKeyReconciliationPaused: "true"})
+}
+
+// GetExternalCreateTime returns the time recorded by whichever
+// external-create annotation is present on the supplied object (pending,
+// succeeded, or failed), and whether one was found.
+func GetExternalCreateTime(o metav1.Object) (time.Time, bool) {
+	a := o.GetAnnotations()
+	for _, k := range []string{AnnotationKeyExternalCreatePending, AnnotationKeyExternalCreateSucceeded, AnnotationKeyExternalCreateFailed} {
+		v, ok := a[k]
+		if !ok {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return t, true
+	}
+	return time.Time{}, false
+}
+
+// SetExternalCreatePending marks the time at which the external resource was
+// requested to be created, clearing any existing succeeded or failed marker.
+func SetExternalCreatePending(o metav1.Object, t time.Time) {
+	RemoveAnnotations(o, AnnotationKeyExternalCreateSucceeded, AnnotationKeyExternalCreateFailed)
+	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreatePending: t.Format(time.RFC3339)})
+}
+
+// SetExternalCreateSucceeded marks the time at which the external resource
+// was confirmed to have been created, clearing the pending and failed
+// markers.
+func SetExternalCreateSucceeded(o metav1.Object, t time.Time) {
+	RemoveAnnotations(o, AnnotationKeyExternalCreatePending, AnnotationKeyExternalCreateFailed)
+	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreateSucceeded: t.Format(time.RFC3339)})
+}
+
+// SetExternalCreateFailed marks the time at which the external resource
+// failed to be created, clearing the pending and succeeded markers.
+func SetExternalCreateFailed(o metav1.Object, t time.Time) {
+	RemoveAnnotations(o, AnnotationKeyExternalCreatePending, AnnotationKeyExternalCreateSucceeded)
+	AddAnnotations(o, map[string]string{AnnotationKeyExternalCreateFailed: t.Format(time.RFC3339)})
+}
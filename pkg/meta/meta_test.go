@@ -18,10 +18,13 @@ package meta
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 )
 
@@ -225,6 +228,171 @@ func TestHaveSameController(t *testing.T) {
 	}
 }
 
+func TestHasOwner(t *testing.T) {
+	owner := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: name, UID: uid}
+	other := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: "other", UID: "a-different-uuid"}
+
+	cases := map[string]struct {
+		o    metav1.Object
+		r    metav1.OwnerReference
+		want bool
+	}{
+		"NoOwners": {
+			o:    &corev1.Pod{},
+			r:    owner,
+			want: false,
+		},
+		"HasOwner": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+			r:    owner,
+			want: true,
+		},
+		"DifferentUID": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{other}}},
+			r:    owner,
+			want: false,
+		},
+		"MultipleOwners": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{other, owner}}},
+			r:    owner,
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := HasOwner(tc.o, tc.r)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("HasOwner(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasAnyOwner(t *testing.T) {
+	owner := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: name, UID: uid}
+
+	cases := map[string]struct {
+		o    metav1.Object
+		want bool
+	}{
+		"NoOwners": {
+			o:    &corev1.Pod{},
+			want: false,
+		},
+		"HasOwners": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := HasAnyOwner(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("HasAnyOwner(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHasController(t *testing.T) {
+	controller := true
+	owner := metav1.OwnerReference{UID: uid}
+	controllerRef := metav1.OwnerReference{UID: uid, Controller: &controller}
+
+	cases := map[string]struct {
+		o    metav1.Object
+		want bool
+	}{
+		"NoOwners": {
+			o:    &corev1.Pod{},
+			want: false,
+		},
+		"OwnerIsNotController": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+			want: false,
+		},
+		"HasController": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{controllerRef}}},
+			want: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := HasController(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("HasController(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWasCreatedByKind(t *testing.T) {
+	gvk := schema.GroupVersionKind{Group: "coolstuff", Version: "v1", Kind: kind}
+	owner := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: name, UID: uid}
+	other := metav1.OwnerReference{APIVersion: "other/v1", Kind: "other", Name: name, UID: uid}
+
+	cases := map[string]struct {
+		o    metav1.Object
+		gvk  schema.GroupVersionKind
+		want bool
+	}{
+		"NoOwners": {
+			o:    &corev1.Pod{},
+			gvk:  gvk,
+			want: false,
+		},
+		"MatchingOwner": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+			gvk:  gvk,
+			want: true,
+		},
+		"NonMatchingOwner": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{other}}},
+			gvk:  gvk,
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := WasCreatedByKind(tc.o, tc.gvk)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("WasCreatedByKind(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestShouldAdopt(t *testing.T) {
+	owner := metav1.OwnerReference{UID: uid}
+
+	cases := map[string]struct {
+		o    metav1.Object
+		want bool
+	}{
+		"NoOwners": {
+			o:    &corev1.Pod{},
+			want: true,
+		},
+		"HasOwners": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := ShouldAdopt(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("ShouldAdopt(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestNamespacedNameOf(t *testing.T) {
 	cases := map[string]struct {
 		r    *corev1.ObjectReference
@@ -302,6 +470,113 @@ func TestAddOwnerReference(t *testing.T) {
 	}
 }
 
+func TestEnsureOwnerReference(t *testing.T) {
+	owner := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: name, UID: uid}
+	other := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: "other", UID: "a-different-uuid"}
+	stale := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: name, UID: "a-stale-uuid"}
+
+	type args struct {
+		o metav1.Object
+		r metav1.OwnerReference
+	}
+
+	cases := map[string]struct {
+		args        args
+		want        []metav1.OwnerReference
+		wantMutated bool
+	}{
+		"NoExistingOwners": {
+			args:        args{o: &corev1.Pod{}, r: owner},
+			want:        []metav1.OwnerReference{owner},
+			wantMutated: true,
+		},
+		"OwnerAlreadyExists": {
+			args: args{
+				o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+				r: owner,
+			},
+			want:        []metav1.OwnerReference{owner},
+			wantMutated: false,
+		},
+		"OwnedByAnotherObject": {
+			args: args{
+				o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{other}}},
+				r: owner,
+			},
+			want:        []metav1.OwnerReference{other, owner},
+			wantMutated: true,
+		},
+		"StaleUIDIsReplaced": {
+			args: args{
+				o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{stale}}},
+				r: owner,
+			},
+			want:        []metav1.OwnerReference{owner},
+			wantMutated: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mutated := EnsureOwnerReference(tc.args.o, tc.args.r)
+
+			if diff := cmp.Diff(tc.wantMutated, mutated); diff != "" {
+				t.Errorf("EnsureOwnerReference(...): -want mutated, +got mutated:\n%s", diff)
+			}
+
+			got := tc.args.o.GetOwnerReferences()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("tc.args.o.GetOwnerReferences(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveOwnerReference(t *testing.T) {
+	owner := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: name, UID: uid}
+	other := metav1.OwnerReference{APIVersion: groupVersion, Kind: kind, Name: "other", UID: "a-different-uuid"}
+
+	type args struct {
+		o metav1.Object
+		r metav1.OwnerReference
+	}
+
+	cases := map[string]struct {
+		args args
+		want []metav1.OwnerReference
+	}{
+		"NoExistingOwners": {
+			args: args{o: &corev1.Pod{}, r: owner},
+			want: nil,
+		},
+		"OwnerExists": {
+			args: args{
+				o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner}}},
+				r: owner,
+			},
+			want: []metav1.OwnerReference{},
+		},
+		"AnotherOwnerExists": {
+			args: args{
+				o: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{owner, other}}},
+				r: owner,
+			},
+			want: []metav1.OwnerReference{other},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			RemoveOwnerReference(tc.args.o, tc.args.r)
+
+			got := tc.args.o.GetOwnerReferences()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("tc.args.o.GetOwnerReferences(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestAddFinalizer(t *testing.T) {
 	finalizer := "fin"
 	funalizer := "fun"
@@ -609,3 +884,445 @@ func TestRemoveAnnotations(t *testing.T) {
 		})
 	}
 }
+
+func TestPropagateLabels(t *testing.T) {
+	type args struct {
+		parent metav1.Object
+		child  metav1.Object
+		opts   []PropagateOption
+	}
+
+	cases := map[string]struct {
+		args args
+		want map[string]string
+	}{
+		"NoMatchingEntries": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"other": "value"}}},
+				child:  &corev1.Pod{},
+				opts:   []PropagateOption{WithKeyPrefix("crossplane.io/")},
+			},
+			want: nil,
+		},
+		"PrefixFiltering": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+					"crossplane.io/cool": "value",
+					"other":              "value",
+				}}},
+				child: &corev1.Pod{},
+				opts:  []PropagateOption{WithKeyPrefix("crossplane.io/")},
+			},
+			want: map[string]string{"crossplane.io/cool": "value"},
+		},
+		"AllowListOnly": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+					"a": "value",
+					"b": "value",
+				}}},
+				child: &corev1.Pod{},
+				opts: []PropagateOption{
+					WithKeyAllowList("a"),
+				},
+			},
+			want: map[string]string{"a": "value"},
+		},
+		"DenyListTakesPrecedenceOverAllowList": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{
+					"a": "value",
+					"b": "value",
+				}}},
+				child: &corev1.Pod{},
+				opts: []PropagateOption{
+					WithKeyAllowList("a", "b"),
+					WithKeyDenyList("b"),
+				},
+			},
+			want: map[string]string{"a": "value"},
+		},
+		"OverwriteFalseKeepsChildValue": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"key": "parent"}}},
+				child:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"key": "child"}}},
+			},
+			want: map[string]string{"key": "child"},
+		},
+		"OverwriteTrueUsesParentValue": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"key": "parent"}}},
+				child:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"key": "child"}}},
+				opts:   []PropagateOption{WithOverwrite(true)},
+			},
+			want: map[string]string{"key": "parent"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			PropagateLabels(tc.args.parent, tc.args.child, tc.args.opts...)
+
+			got := tc.args.child.GetLabels()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("PropagateLabels(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPropagateAnnotations(t *testing.T) {
+	type args struct {
+		parent metav1.Object
+		child  metav1.Object
+		opts   []PropagateOption
+	}
+
+	cases := map[string]struct {
+		args args
+		want map[string]string
+	}{
+		"NoMatchingEntries": {
+			args: args{
+				parent: &corev1.Pod{},
+				child:  &corev1.Pod{},
+			},
+			want: nil,
+		},
+		"DenyListWins": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+					"a": "value",
+					"b": "value",
+				}}},
+				child: &corev1.Pod{},
+				opts:  []PropagateOption{WithKeyDenyList("b")},
+			},
+			want: map[string]string{"a": "value"},
+		},
+		"OverwriteFalseKeepsChildValue": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"key": "parent"}}},
+				child:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"key": "child"}}},
+			},
+			want: map[string]string{"key": "child"},
+		},
+		"OverwriteTrueUsesParentValue": {
+			args: args{
+				parent: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"key": "parent"}}},
+				child:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"key": "child"}}},
+				opts:   []PropagateOption{WithOverwrite(true)},
+			},
+			want: map[string]string{"key": "parent"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			PropagateAnnotations(tc.args.parent, tc.args.child, tc.args.opts...)
+
+			got := tc.args.child.GetAnnotations()
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("PropagateAnnotations(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetControllerReference(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("corev1.AddToScheme(): %s", err)
+	}
+
+	controller := true
+	blockOwnerDeletion := true
+
+	owner := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: uid}}
+
+	other := true
+	existing := metav1.OwnerReference{
+		APIVersion: "v1",
+		Kind:       "Pod",
+		Name:       "another",
+		UID:        types.UID("a-different-uuid"),
+		Controller: &other,
+	}
+
+	type args struct {
+		owner      metav1.Object
+		controlled metav1.Object
+	}
+
+	cases := map[string]struct {
+		args    args
+		want    metav1.OwnerReference
+		wantErr bool
+	}{
+		"Successful": {
+			args: args{
+				owner:      owner,
+				controlled: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "controlled"}},
+			},
+			want: metav1.OwnerReference{
+				APIVersion:         "v1",
+				Kind:               "Pod",
+				Name:               name,
+				UID:                uid,
+				Controller:         &controller,
+				BlockOwnerDeletion: &blockOwnerDeletion,
+			},
+		},
+		"AlreadyControlledByAnother": {
+			args: args{
+				owner: owner,
+				controlled: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+					Namespace:       namespace,
+					Name:            "controlled",
+					OwnerReferences: []metav1.OwnerReference{existing},
+				}},
+			},
+			wantErr: true,
+		},
+		"ClusterScopedControlled": {
+			args: args{
+				owner:      owner,
+				controlled: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "controlled"}},
+			},
+			wantErr: true,
+		},
+		"CrossNamespace": {
+			args: args{
+				owner:      owner,
+				controlled: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "other-ns", Name: "controlled"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := SetControllerReference(tc.args.owner, tc.args.controlled, scheme)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("SetControllerReference(): expected an error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SetControllerReference(): unexpected error: %s", err)
+			}
+
+			got := metav1.GetControllerOf(tc.args.controlled)
+			if diff := cmp.Diff(&tc.want, got); diff != "" {
+				t.Errorf("GetControllerOf(): -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("AlreadyOwnedErrorType", func(t *testing.T) {
+		controlled := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       namespace,
+			Name:            "controlled",
+			OwnerReferences: []metav1.OwnerReference{existing},
+		}}
+
+		err := SetControllerReference(owner, controlled, scheme)
+		if _, ok := err.(*AlreadyOwnedError); !ok {
+			t.Errorf("SetControllerReference(): want *AlreadyOwnedError, got %T", err)
+		}
+	})
+}
+
+func TestEnsureControllerReference(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("corev1.AddToScheme(): %s", err)
+	}
+
+	owner := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, UID: uid}}
+
+	type args struct {
+		owner      metav1.Object
+		controlled metav1.Object
+	}
+
+	cases := map[string]struct {
+		args        args
+		wantMutated bool
+		wantErr     bool
+	}{
+		"NotYetController": {
+			args: args{
+				owner:      owner,
+				controlled: &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "controlled"}},
+			},
+			wantMutated: true,
+		},
+		"AlreadyController": {
+			args: args{
+				owner: owner,
+				controlled: func() metav1.Object {
+					c := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: "controlled"}}
+					if err := SetControllerReference(owner, c, scheme); err != nil {
+						t.Fatalf("SetControllerReference(): %s", err)
+					}
+					return c
+				}(),
+			},
+			wantMutated: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			mutated, err := EnsureControllerReference(tc.args.owner, tc.args.controlled, scheme)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("EnsureControllerReference(): unexpected error: %s", err)
+			}
+			if diff := cmp.Diff(tc.wantMutated, mutated); diff != "" {
+				t.Errorf("EnsureControllerReference(): -want mutated, +got mutated:\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExternalName(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		want string
+	}{
+		"NoAnnotations": {
+			o:    &corev1.Pod{},
+			want: "",
+		},
+		"HasExternalName": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyExternalName: "cool-external-name"}}},
+			want: "cool-external-name",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := GetExternalName(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("GetExternalName(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("SetExternalName", func(t *testing.T) {
+		o := &corev1.Pod{}
+		SetExternalName(o, "cool-external-name")
+		if diff := cmp.Diff("cool-external-name", GetExternalName(o)); diff != "" {
+			t.Errorf("GetExternalName(...): -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestPaused(t *testing.T) {
+	cases := map[string]struct {
+		o    metav1.Object
+		want bool
+	}{
+		"NoAnnotations": {
+			o:    &corev1.Pod{},
+			want: false,
+		},
+		"Paused": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconciliationPaused: "true"}}},
+			want: true,
+		},
+		"NotPaused": {
+			o:    &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{AnnotationKeyReconciliationPaused: "false"}}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := IsPaused(tc.o)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("IsPaused(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("SetPaused", func(t *testing.T) {
+		o := &corev1.Pod{}
+
+		SetPaused(o, true)
+		if diff := cmp.Diff(true, IsPaused(o)); diff != "" {
+			t.Errorf("IsPaused(...): -want, +got:\n%s", diff)
+		}
+
+		SetPaused(o, false)
+		if diff := cmp.Diff(false, IsPaused(o)); diff != "" {
+			t.Errorf("IsPaused(...): -want, +got:\n%s", diff)
+		}
+	})
+}
+
+func TestExternalCreateTime(t *testing.T) {
+	at := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("NoAnnotations", func(t *testing.T) {
+		_, ok := GetExternalCreateTime(&corev1.Pod{})
+		if ok {
+			t.Errorf("GetExternalCreateTime(...): want ok=false, got true")
+		}
+	})
+
+	cases := map[string]struct {
+		set func(o metav1.Object, t time.Time)
+	}{
+		"Pending":   {set: SetExternalCreatePending},
+		"Succeeded": {set: SetExternalCreateSucceeded},
+		"Failed":    {set: SetExternalCreateFailed},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			o := &corev1.Pod{}
+			tc.set(o, at)
+
+			got, ok := GetExternalCreateTime(o)
+			if !ok {
+				t.Fatalf("GetExternalCreateTime(...): want ok=true, got false")
+			}
+			if diff := cmp.Diff(at, got); diff != "" {
+				t.Errorf("GetExternalCreateTime(...): -want, +got:\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("SucceededClearsPending", func(t *testing.T) {
+		o := &corev1.Pod{}
+		SetExternalCreatePending(o, at)
+		SetExternalCreateSucceeded(o, at)
+
+		if _, ok := o.GetAnnotations()[AnnotationKeyExternalCreatePending]; ok {
+			t.Errorf("AnnotationKeyExternalCreatePending: want cleared, still present")
+		}
+	})
+
+	t.Run("SucceededClearsFailed", func(t *testing.T) {
+		o := &corev1.Pod{}
+		SetExternalCreateFailed(o, at)
+		SetExternalCreateSucceeded(o, at)
+
+		if _, ok := o.GetAnnotations()[AnnotationKeyExternalCreateFailed]; ok {
+			t.Errorf("AnnotationKeyExternalCreateFailed: want cleared, still present")
+		}
+	})
+
+	t.Run("FailedClearsSucceeded", func(t *testing.T) {
+		o := &corev1.Pod{}
+		SetExternalCreateSucceeded(o, at)
+		SetExternalCreateFailed(o, at)
+
+		if _, ok := o.GetAnnotations()[AnnotationKeyExternalCreateSucceeded]; ok {
+			t.Errorf("AnnotationKeyExternalCreateSucceeded: want cleared, still present")
+		}
+	})
+}